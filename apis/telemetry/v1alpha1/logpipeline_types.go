@@ -0,0 +1,276 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-telemetry,kyma-telemetry-pipelines},scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+type LogPipeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogPipelineSpec   `json:"spec,omitempty"`
+	Status LogPipelineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type LogPipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogPipeline `json:"items"`
+}
+
+type LogPipelineSpec struct {
+	// Output configures the backend the collected logs are shipped to. Exactly one output type must be defined.
+	Output Output `json:"output,omitempty"`
+
+	// RetryPolicy overrides Fluent Bit's default retry/backoff scheduling for the output defined above.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// RetryPolicy tunes Fluent Bit's scheduler for the output of a LogPipeline. By default, Fluent Bit retries failed
+// chunks with a truncated exponential backoff capped so that buffered logs are retried for roughly 3 days before
+// being dropped. Use this field to trade that safety ceiling for faster failure detection or longer outage
+// tolerance, e.g. when an OTLP exporter on the receiving end exposes its own retry knobs.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries per chunk. Set to -1 for infinite retries (a warning is emitted
+	// on admission since this can grow the filesystem buffer without bound). If unset, it is derived from
+	// BaseSeconds/CapSeconds so that retries still cover roughly 3 days.
+	// +optional
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// BaseSeconds is Fluent Bit's scheduler.base: the initial retry delay in seconds. Must be greater than 0.
+	// +optional
+	BaseSeconds *int `json:"baseSeconds,omitempty"`
+
+	// CapSeconds is Fluent Bit's scheduler.cap: the maximum retry delay in seconds. Must be greater than 0 and
+	// greater than or equal to BaseSeconds.
+	// +optional
+	CapSeconds *int `json:"capSeconds,omitempty"`
+
+	// JitterEnabled toggles Fluent Bit's scheduler jitter, which randomizes retry delays to avoid thundering-herd
+	// reconnects against the same sink.
+	// +optional
+	JitterEnabled *bool `json:"jitterEnabled,omitempty"`
+}
+
+type LogPipelineStatus struct {
+	// Conditions contain a set of conditions for this pipeline.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// LogPipelineOutputHealthy reports whether the resolved output endpoint of the pipeline is reachable, as
+	// determined by periodically probing it.
+	LogPipelineOutputHealthy = "OutputHealthy"
+	// LogPipelineTLSCertificateReady mirrors cert-manager's Ready condition for a TLSConfig.CertificateRef.
+	LogPipelineTLSCertificateReady = "TLSCertificateReady"
+)
+
+const (
+	// AnnotationProbeInterval overrides the default output probe interval for a single pipeline, e.g. "30s".
+	AnnotationProbeInterval = "telemetry.kyma-project.io/probe-interval"
+	// AnnotationProbeTimeout overrides the default output probe timeout for a single pipeline, e.g. "5s".
+	AnnotationProbeTimeout = "telemetry.kyma-project.io/probe-timeout"
+)
+
+// Output describes a LogPipeline output. Exactly one of Custom, HTTP, Loki, or OTLP must be set.
+type Output struct {
+	// Custom defines a custom output in the Fluent Bit configuration language.
+	// +optional
+	Custom string `json:"custom,omitempty"`
+
+	// HTTP configures an HTTP output.
+	// +optional
+	HTTP *HTTPOutput `json:"http,omitempty"`
+
+	// Loki configures a Grafana Loki output.
+	// +optional
+	Loki *LokiOutput `json:"loki,omitempty"`
+
+	// OTLP configures an OTLP/HTTP output targeting any OTLP-compatible collector.
+	// +optional
+	OTLP *OTLPOutput `json:"otlp,omitempty"`
+}
+
+func (o *Output) IsCustomDefined() bool {
+	return o.Custom != ""
+}
+
+func (o *Output) IsHTTPDefined() bool {
+	return o.HTTP != nil && o.HTTP.Host.IsDefined()
+}
+
+func (o *Output) IsLokiDefined() bool {
+	return o.Loki != nil && o.Loki.URL.IsDefined()
+}
+
+func (o *Output) IsOTLPDefined() bool {
+	return o.OTLP != nil && o.OTLP.Endpoint.IsDefined()
+}
+
+type HTTPOutput struct {
+	Host     ValueType `json:"host,omitempty"`
+	User     ValueType `json:"user,omitempty"`
+	Password ValueType `json:"password,omitempty"`
+	URI      string    `json:"uri,omitempty"`
+	Port     string    `json:"port,omitempty"`
+	Format   string    `json:"format,omitempty"`
+
+	// Compress enables request body compression. Leave unset to send uncompressed. Fluent Bit's http output only
+	// supports gzip; zstd is not an accepted value.
+	// +kubebuilder:validation:Enum=gzip
+	// +optional
+	Compress string `json:"compress,omitempty"`
+
+	// TLSConfig configures TLS for the connection to the HTTP backend.
+	// +optional
+	TLSConfig TLSConfig `json:"tls,omitempty"`
+}
+
+type LokiOutput struct {
+	URL        ValueType         `json:"url,omitempty"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	RemoveKeys []string          `json:"removeKeys,omitempty"`
+
+	// TLSConfig configures TLS for the connection to the Loki backend.
+	// +optional
+	TLSConfig TLSConfig `json:"tls,omitempty"`
+}
+
+// OTLPOutput configures a Fluent Bit `opentelemetry` output section targeting an OTLP/HTTP logs endpoint.
+type OTLPOutput struct {
+	// Endpoint is the OTLP/HTTP collector endpoint, e.g. "https://otlp-collector:4318". The scheme and port are
+	// split out of it for Fluent Bit's `host`/`port` directives; if Endpoint is sourced from a secret and resolves
+	// to a bare hostname without a port, Port below supplies it instead.
+	Endpoint ValueType `json:"endpoint,omitempty"`
+
+	// Port is used as the opentelemetry output's port when it cannot be parsed out of Endpoint. Defaults to "4318".
+	// +optional
+	Port string `json:"port,omitempty"`
+
+	// Path is appended to Endpoint. Defaults to "/v1/logs".
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Headers are added to every export request, e.g. for bearer-token authentication. Values may reference
+	// secrets via ValueFrom.
+	// +optional
+	Headers map[string]ValueType `json:"headers,omitempty"`
+
+	// Compression is applied to the request body. Defaults to "gzip". Fluent Bit's opentelemetry output only
+	// supports gzip; zstd is not an accepted value.
+	// +kubebuilder:validation:Enum=none;gzip
+	// +optional
+	Compression string `json:"compression,omitempty"`
+
+	// Protocol selects the OTLP wire format. Defaults to "http/protobuf".
+	// +kubebuilder:validation:Enum=http/protobuf;http/json
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// TLSConfig configures TLS for the connection to the OTLP backend.
+	// +optional
+	TLSConfig TLSConfig `json:"tls,omitempty"`
+}
+
+type TLSConfig struct {
+	// Disabled turns TLS off entirely.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// SkipCertificateValidation disables verification of the backend's certificate chain and host name.
+	// +optional
+	SkipCertificateValidation bool `json:"skipCertificateValidation,omitempty"`
+
+	// CA is the CA certificate used to verify the backend's certificate.
+	// +optional
+	CA ValueType `json:"ca,omitempty"`
+
+	// Cert is the client certificate used for mutual TLS.
+	// +optional
+	Cert ValueType `json:"cert,omitempty"`
+
+	// Key is the client private key used for mutual TLS.
+	// +optional
+	Key ValueType `json:"key,omitempty"`
+
+	// CertificateRef sources CA/Cert/Key material from a cert-manager.io/v1 Certificate instead of requiring CA/
+	// Cert/Key to be populated manually. The manager watches the Certificate's secret and rotates the mounted
+	// files accordingly. Mutually exclusive with CA/Cert/Key.
+	// +optional
+	CertificateRef *CertificateRef `json:"certificateRef,omitempty"`
+}
+
+// HasCA reports whether CA material is available, either supplied directly or via a cert-manager Certificate.
+func (t *TLSConfig) HasCA() bool {
+	return t.CA.IsDefined() || t.CertificateRef != nil
+}
+
+// HasCert reports whether client certificate material is available, either supplied directly or via a
+// cert-manager Certificate.
+func (t *TLSConfig) HasCert() bool {
+	return t.Cert.IsDefined() || t.CertificateRef != nil
+}
+
+// HasKey reports whether client key material is available, either supplied directly or via a cert-manager
+// Certificate.
+func (t *TLSConfig) HasKey() bool {
+	return t.Key.IsDefined() || t.CertificateRef != nil
+}
+
+// CertificateRef references a cert-manager.io/v1 Certificate that supplies TLS material for an output.
+type CertificateRef struct {
+	// Name references an existing cert-manager Certificate in the pipeline's namespace that already manages the
+	// backend's TLS secret.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// IssuerRef creates a Certificate on the pipeline's behalf, issued by the referenced Issuer or ClusterIssuer.
+	// Required unless Name is set.
+	// +optional
+	IssuerRef *IssuerReference `json:"issuerRef,omitempty"`
+
+	// DNSNames are the DNS SANs for the Certificate created via IssuerRef.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+}
+
+// IssuerReference identifies the cert-manager Issuer or ClusterIssuer that should issue a Certificate.
+type IssuerReference struct {
+	Name string `json:"name,omitempty"`
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	Kind string `json:"kind,omitempty"`
+}
+
+// ValueType references either an inline Value or a ValueFrom source such as a secret key.
+type ValueType struct {
+	Value     string         `json:"value,omitempty"`
+	ValueFrom *ValueFromType `json:"valueFrom,omitempty"`
+}
+
+func (v *ValueType) IsDefined() bool {
+	if v == nil {
+		return false
+	}
+	return v.Value != "" || (v.ValueFrom != nil && v.ValueFrom.IsSecretKeyRef())
+}
+
+type ValueFromType struct {
+	SecretKeyRef *SecretKeyRef `json:"secretKeyRef,omitempty"`
+}
+
+func (v *ValueFromType) IsSecretKeyRef() bool {
+	return v != nil && v.SecretKeyRef != nil
+}
+
+type SecretKeyRef struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Key       string `json:"key,omitempty"`
+}
@@ -0,0 +1,130 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/validate-logpipeline,mutating=false,failurePolicy=fail,sideEffects=None,groups=telemetry.kyma-project.io,resources=logpipelines,verbs=create;update,versions=v1alpha1,name=vlogpipeline.kb.io,admissionReviewVersions=v1
+
+func (p *LogPipeline) ValidateCreate() (admission.Warnings, error) {
+	return p.validate()
+}
+
+func (p *LogPipeline) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return p.validate()
+}
+
+func (p *LogPipeline) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (p *LogPipeline) validate() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	retryWarnings, err := p.validateRetryPolicy()
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, retryWarnings...)
+
+	if err := p.validateCompression(); err != nil {
+		return nil, err
+	}
+
+	if err := p.validateCertificateRef(); err != nil {
+		return nil, err
+	}
+
+	return warnings, nil
+}
+
+func (p *LogPipeline) validateRetryPolicy() (admission.Warnings, error) {
+	retryPolicy := p.Spec.RetryPolicy
+	if retryPolicy == nil {
+		return nil, nil
+	}
+
+	if retryPolicy.BaseSeconds != nil && *retryPolicy.BaseSeconds <= 0 {
+		return nil, fmt.Errorf("spec.retryPolicy.baseSeconds must be greater than 0, got %d", *retryPolicy.BaseSeconds)
+	}
+
+	if retryPolicy.CapSeconds != nil && *retryPolicy.CapSeconds <= 0 {
+		return nil, fmt.Errorf("spec.retryPolicy.capSeconds must be greater than 0, got %d", *retryPolicy.CapSeconds)
+	}
+
+	if retryPolicy.BaseSeconds != nil && retryPolicy.CapSeconds != nil && *retryPolicy.CapSeconds < *retryPolicy.BaseSeconds {
+		return nil, fmt.Errorf("spec.retryPolicy.capSeconds (%d) must be greater than or equal to spec.retryPolicy.baseSeconds (%d)",
+			*retryPolicy.CapSeconds, *retryPolicy.BaseSeconds)
+	}
+
+	if retryPolicy.MaxRetries == nil {
+		return nil, nil
+	}
+
+	switch {
+	case *retryPolicy.MaxRetries == -1:
+		return admission.Warnings{"spec.retryPolicy.maxRetries is set to -1 (infinite retries): a permanently unreachable output will grow the filesystem buffer without bound"}, nil
+	case *retryPolicy.MaxRetries > 0:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("spec.retryPolicy.maxRetries must be -1 (infinite) or greater than 0, got %d", *retryPolicy.MaxRetries)
+	}
+}
+
+func (p *LogPipeline) validateCompression() error {
+	if http := p.Spec.Output.HTTP; http != nil {
+		if err := validateEnum(http.Compress, "gzip"); err != nil {
+			return fmt.Errorf("spec.output.http.compress: %w", err)
+		}
+	}
+	if otlp := p.Spec.Output.OTLP; otlp != nil {
+		if err := validateEnum(otlp.Compression, "none", "gzip"); err != nil {
+			return fmt.Errorf("spec.output.otlp.compression: %w", err)
+		}
+	}
+	return nil
+}
+
+func (p *LogPipeline) validateCertificateRef() error {
+	if http := p.Spec.Output.HTTP; http != nil {
+		if err := validateCertificateRefFields(http.TLSConfig.CertificateRef); err != nil {
+			return fmt.Errorf("spec.output.http.tls.certificateRef: %w", err)
+		}
+	}
+	if loki := p.Spec.Output.Loki; loki != nil {
+		if err := validateCertificateRefFields(loki.TLSConfig.CertificateRef); err != nil {
+			return fmt.Errorf("spec.output.loki.tls.certificateRef: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateCertificateRefFields requires exactly one of Name/IssuerRef to be set, since EnsureCertificate
+// unconditionally dereferences IssuerRef when Name is empty.
+func validateCertificateRefFields(ref *CertificateRef) error {
+	if ref == nil {
+		return nil
+	}
+	if ref.Name == "" && ref.IssuerRef == nil {
+		return fmt.Errorf("exactly one of name or issuerRef must be set, got neither")
+	}
+	if ref.Name != "" && ref.IssuerRef != nil {
+		return fmt.Errorf("exactly one of name or issuerRef must be set, got both")
+	}
+	return nil
+}
+
+func validateEnum(value string, allowed ...string) error {
+	if value == "" {
+		return nil
+	}
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %v, got %q", allowed, value)
+}
@@ -0,0 +1,116 @@
+package v1alpha1
+
+import "testing"
+
+func TestValidateRetryPolicy(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	tests := []struct {
+		name        string
+		retryPolicy *RetryPolicy
+		wantErr     bool
+		wantWarning bool
+	}{
+		{name: "nil policy", retryPolicy: nil, wantErr: false},
+		{name: "valid base and cap", retryPolicy: &RetryPolicy{BaseSeconds: intPtr(1), CapSeconds: intPtr(1800)}, wantErr: false},
+		{name: "zero base", retryPolicy: &RetryPolicy{BaseSeconds: intPtr(0)}, wantErr: true},
+		{name: "negative base", retryPolicy: &RetryPolicy{BaseSeconds: intPtr(-1)}, wantErr: true},
+		{name: "zero cap", retryPolicy: &RetryPolicy{CapSeconds: intPtr(0)}, wantErr: true},
+		{name: "negative cap", retryPolicy: &RetryPolicy{CapSeconds: intPtr(-1)}, wantErr: true},
+		{name: "cap less than base", retryPolicy: &RetryPolicy{BaseSeconds: intPtr(10), CapSeconds: intPtr(5)}, wantErr: true},
+		{name: "maxRetries infinite emits warning", retryPolicy: &RetryPolicy{MaxRetries: intPtr(-1)}, wantErr: false, wantWarning: true},
+		{name: "maxRetries zero rejected", retryPolicy: &RetryPolicy{MaxRetries: intPtr(0)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &LogPipeline{Spec: LogPipelineSpec{RetryPolicy: tt.retryPolicy}}
+			warnings, err := p.validateRetryPolicy()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateRetryPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (len(warnings) > 0) != tt.wantWarning {
+				t.Errorf("validateRetryPolicy() warnings = %v, wantWarning %v", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestValidateCompression(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  Output
+		wantErr bool
+	}{
+		{name: "no output", output: Output{}, wantErr: false},
+		{name: "http gzip", output: Output{HTTP: &HTTPOutput{Compress: "gzip"}}, wantErr: false},
+		{name: "http zstd is not supported by Fluent Bit", output: Output{HTTP: &HTTPOutput{Compress: "zstd"}}, wantErr: true},
+		{name: "http unsupported value", output: Output{HTTP: &HTTPOutput{Compress: "bogus"}}, wantErr: true},
+		{name: "otlp none", output: Output{OTLP: &OTLPOutput{Compression: "none"}}, wantErr: false},
+		{name: "otlp gzip", output: Output{OTLP: &OTLPOutput{Compression: "gzip"}}, wantErr: false},
+		{name: "otlp zstd is not supported by Fluent Bit", output: Output{OTLP: &OTLPOutput{Compression: "zstd"}}, wantErr: true},
+		{name: "otlp unsupported value", output: Output{OTLP: &OTLPOutput{Compression: "bogus"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &LogPipeline{Spec: LogPipelineSpec{Output: tt.output}}
+			err := p.validateCompression()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateCompression() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCertificateRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  Output
+		wantErr bool
+	}{
+		{name: "no output", output: Output{}, wantErr: false},
+		{name: "http no certificateRef", output: Output{HTTP: &HTTPOutput{}}, wantErr: false},
+		{
+			name:    "http name only",
+			output:  Output{HTTP: &HTTPOutput{TLSConfig: TLSConfig{CertificateRef: &CertificateRef{Name: "my-cert"}}}},
+			wantErr: false,
+		},
+		{
+			name: "http issuerRef only",
+			output: Output{HTTP: &HTTPOutput{TLSConfig: TLSConfig{CertificateRef: &CertificateRef{
+				IssuerRef: &IssuerReference{Name: "my-issuer", Kind: "ClusterIssuer"},
+				DNSNames:  []string{"backend.example.com"},
+			}}}},
+			wantErr: false,
+		},
+		{
+			name:    "http neither name nor issuerRef",
+			output:  Output{HTTP: &HTTPOutput{TLSConfig: TLSConfig{CertificateRef: &CertificateRef{DNSNames: []string{"backend.example.com"}}}}},
+			wantErr: true,
+		},
+		{
+			name: "http both name and issuerRef",
+			output: Output{HTTP: &HTTPOutput{TLSConfig: TLSConfig{CertificateRef: &CertificateRef{
+				Name:      "my-cert",
+				IssuerRef: &IssuerReference{Name: "my-issuer", Kind: "ClusterIssuer"},
+			}}}},
+			wantErr: true,
+		},
+		{
+			name:    "loki neither name nor issuerRef",
+			output:  Output{Loki: &LokiOutput{TLSConfig: TLSConfig{CertificateRef: &CertificateRef{DNSNames: []string{"backend.example.com"}}}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &LogPipeline{Spec: LogPipelineSpec{Output: tt.output}}
+			err := p.validateCertificateRef()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateCertificateRef() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
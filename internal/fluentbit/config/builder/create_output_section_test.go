@@ -0,0 +1,22 @@
+package builder
+
+import (
+	"testing"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestCreateOutputSectionNotRenderable(t *testing.T) {
+	pipeline := &telemetryv1alpha1.LogPipeline{
+		Spec: telemetryv1alpha1.LogPipelineSpec{
+			Output: telemetryv1alpha1.Output{
+				HTTP: &telemetryv1alpha1.HTTPOutput{Host: telemetryv1alpha1.ValueType{Value: "backend"}},
+			},
+		},
+	}
+
+	got := createOutputSection(pipeline, PipelineDefaults{}, false)
+	if got != "" {
+		t.Errorf("createOutputSection(renderable=false) = %q, want empty string", got)
+	}
+}
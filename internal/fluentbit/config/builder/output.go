@@ -2,37 +2,118 @@ package builder
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 
 	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
 	"github.com/kyma-project/telemetry-manager/internal/utils/envvar"
 )
 
-// Considering Fluent Bit's exponential back-off and jitter algorithm with the default scheduler.base and scheduler.cap,
-// this retry limit should be enough to cover about 3 days of retrying. See
-// https://docs.fluentbit.io/manual/administration/scheduling-and-retries. We do not want unlimited retries to avoid
-// that malformed logs stay in the buffer forever.
-var retryLimit = "300"
+const (
+	// defaultRetryLimit, combined with the default scheduler base/cap below, covers about 3 days of retrying
+	// Fluent Bit's truncated exponential back-off and jitter algorithm (effectiveMaxRetries(defaultSchedulerBaseSeconds,
+	// defaultSchedulerCapSeconds) == 154, which is where this number comes from). See
+	// https://docs.fluentbit.io/manual/administration/scheduling-and-retries. We do not want unlimited retries to
+	// avoid that malformed logs stay in the buffer forever.
+	defaultRetryLimit = 154
+
+	// defaultSchedulerBaseSeconds and defaultSchedulerCapSeconds mirror Fluent Bit's own scheduler defaults.
+	defaultSchedulerBaseSeconds = 1
+	defaultSchedulerCapSeconds  = 1800
+
+	// targetRetryCoverageSeconds is the retry window defaultRetryLimit was chosen to cover, used to derive an
+	// effective MaxRetries when a RetryPolicy only overrides BaseSeconds/CapSeconds.
+	targetRetryCoverageSeconds = 3 * 24 * 60 * 60
+)
+
+// createOutputSection renders pipeline's output section. renderable gates whether anything is rendered at all: the
+// caller is expected to pass the result of certificate.ShouldRender here, so a TLSConfig.CertificateRef that has not
+// become Ready yet causes the output to be omitted from the config instead of pointing Fluent Bit at TLS files that
+// do not exist.
+func createOutputSection(pipeline *telemetryv1alpha1.LogPipeline, defaults PipelineDefaults, renderable bool) string {
+	if !renderable {
+		return ""
+	}
 
-func createOutputSection(pipeline *telemetryv1alpha1.LogPipeline, defaults PipelineDefaults) string {
 	output := &pipeline.Spec.Output
+	retryPolicy := pipeline.Spec.RetryPolicy
 	if output.IsCustomDefined() {
-		return generateCustomOutput(output, defaults.FsBufferLimit, pipeline.Name)
+		return generateCustomOutput(output, defaults.FsBufferLimit, pipeline.Name, retryPolicy)
 	}
 
 	if output.IsHTTPDefined() {
-		return generateHTTPOutput(output.HTTP, defaults.FsBufferLimit, pipeline.Name)
+		return generateHTTPOutput(output.HTTP, defaults.FsBufferLimit, pipeline.Name, retryPolicy)
 	}
 
 	if output.IsLokiDefined() {
-		return generateLokiOutput(output.Loki, defaults.FsBufferLimit, pipeline.Name)
+		return generateLokiOutput(output.Loki, defaults.FsBufferLimit, pipeline.Name, retryPolicy)
+	}
+
+	if output.IsOTLPDefined() {
+		return generateOTLPOutput(output.OTLP, defaults.FsBufferLimit, pipeline.Name, retryPolicy)
 	}
 
 	return ""
 }
 
-func generateCustomOutput(output *telemetryv1alpha1.Output, fsBufferLimit string, name string) string {
+// retrySettings derives the retry_limit, scheduler.base and scheduler.cap config params for an output section from
+// the pipeline's RetryPolicy, falling back to the package defaults for scheduler.base/scheduler.cap when policy is nil
+// or leaves them unset. retry_limit is MaxRetries when set (-1, infinite retries, is passed through as is), else
+// effectiveMaxRetries when BaseSeconds or CapSeconds is overridden, else defaultRetryLimit. It is always emitted
+// rather than leaving Fluent Bit's own default in effect.
+func retrySettings(policy *telemetryv1alpha1.RetryPolicy) (retryLimit, schedulerBase, schedulerCap string) {
+	base := defaultSchedulerBaseSeconds
+	capSeconds := defaultSchedulerCapSeconds
+	if policy != nil {
+		if policy.BaseSeconds != nil {
+			base = *policy.BaseSeconds
+		}
+		if policy.CapSeconds != nil {
+			capSeconds = *policy.CapSeconds
+		}
+	}
+
+	limit := defaultRetryLimit
+	switch {
+	case policy != nil && policy.MaxRetries != nil:
+		limit = *policy.MaxRetries
+	case policy != nil && (policy.BaseSeconds != nil || policy.CapSeconds != nil):
+		limit = effectiveMaxRetries(base, capSeconds)
+	}
+
+	return strconv.Itoa(limit), strconv.Itoa(base), strconv.Itoa(capSeconds)
+}
+
+// effectiveMaxRetries approximates the number of retries a truncated exponential backoff (delay = min(cap,
+// base*2^n)) needs to cover targetRetryCoverageSeconds, so overriding BaseSeconds/CapSeconds alone does not
+// silently shrink the existing ~3 day safety ceiling.
+func effectiveMaxRetries(baseSeconds, capSeconds int) int {
+	if baseSeconds <= 0 {
+		baseSeconds = defaultSchedulerBaseSeconds
+	}
+	if capSeconds < baseSeconds {
+		capSeconds = baseSeconds
+	}
+
+	delay := baseSeconds
+	total := 0
+	n := 0
+	for total < targetRetryCoverageSeconds {
+		total += delay
+		n++
+		if delay < capSeconds {
+			delay *= 2
+			if delay > capSeconds {
+				delay = capSeconds
+			}
+		}
+	}
+	return n
+}
+
+func generateCustomOutput(output *telemetryv1alpha1.Output, fsBufferLimit string, name string, retryPolicy *telemetryv1alpha1.RetryPolicy) string {
 	sb := NewOutputSectionBuilder()
 	customOutputParams := parseMultiline(output.Custom)
 	var outputName string
@@ -48,20 +129,20 @@ func generateCustomOutput(output *telemetryv1alpha1.Output, fsBufferLimit string
 	}
 	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
 	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
-	sb.AddConfigParam("retry_limit", retryLimit)
+	addRetrySchedule(sb, retryPolicy)
 	return sb.Build()
 }
 
-func generateHTTPOutput(httpOutput *telemetryv1alpha1.HTTPOutput, fsBufferLimit string, name string) string {
+func generateHTTPOutput(httpOutput *telemetryv1alpha1.HTTPOutput, fsBufferLimit string, name string, retryPolicy *telemetryv1alpha1.RetryPolicy) string {
 	sb := NewOutputSectionBuilder()
 	sb.AddConfigParam("name", "http")
 	sb.AddConfigParam("allow_duplicated_headers", "true")
 	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
 	sb.AddConfigParam("alias", fmt.Sprintf("%s-http", name))
 	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
-	sb.AddConfigParam("retry_limit", retryLimit)
+	addRetrySchedule(sb, retryPolicy)
 	sb.AddIfNotEmpty("uri", httpOutput.URI)
-	sb.AddIfNotEmpty("compress", httpOutput.Compress)
+	addCompression(sb, httpOutput.Compress, false)
 	sb.AddIfNotEmptyOrDefault("port", httpOutput.Port, "443")
 	sb.AddIfNotEmptyOrDefault("format", httpOutput.Format, "json")
 
@@ -77,36 +158,19 @@ func generateHTTPOutput(httpOutput *telemetryv1alpha1.HTTPOutput, fsBufferLimit
 		value := resolveValue(httpOutput.User, name)
 		sb.AddConfigParam("http_user", value)
 	}
-	tlsEnabled := "on"
-	if httpOutput.TLSConfig.Disabled {
-		tlsEnabled = "off"
-	}
-	sb.AddConfigParam("tls", tlsEnabled)
-	tlsVerify := "on"
-	if httpOutput.TLSConfig.SkipCertificateValidation {
-		tlsVerify = "off"
-	}
-	sb.AddConfigParam("tls.verify", tlsVerify)
-	if httpOutput.TLSConfig.CA.IsDefined() {
-		sb.AddConfigParam("tls.ca_file", fmt.Sprintf("/fluent-bit/tls/%s-ca.crt", name))
-	}
-	if httpOutput.TLSConfig.Cert.IsDefined() {
-		sb.AddConfigParam("tls.crt_file", fmt.Sprintf("/fluent-bit/tls/%s-cert.crt", name))
-	}
-	if httpOutput.TLSConfig.Key.IsDefined() {
-		sb.AddConfigParam("tls.key_file", fmt.Sprintf("/fluent-bit/tls/%s-key.key", name))
-	}
+	addTLSParams(sb, httpOutput.TLSConfig, name)
 
 	return sb.Build()
 }
 
-func generateLokiOutput(lokiOutput *telemetryv1alpha1.LokiOutput, fsBufferLimit string, name string) string {
+func generateLokiOutput(lokiOutput *telemetryv1alpha1.LokiOutput, fsBufferLimit string, name string, retryPolicy *telemetryv1alpha1.RetryPolicy) string {
 	sb := NewOutputSectionBuilder()
 	sb.AddConfigParam("labelMapPath", "/fluent-bit/etc/loki-labelmap.json")
 	sb.AddConfigParam("loglevel", "warn")
 	sb.AddConfigParam("lineformat", "json")
 	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
 	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
+	addRetrySchedule(sb, retryPolicy)
 	sb.AddConfigParam("name", "grafana-loki")
 	sb.AddConfigParam("alias", fmt.Sprintf("%s-grafana-loki", name))
 	sb.AddConfigParam("url", resolveValue(lokiOutput.URL, name))
@@ -118,9 +182,107 @@ func generateLokiOutput(lokiOutput *telemetryv1alpha1.LokiOutput, fsBufferLimit
 		str := strings.Join(lokiOutput.RemoveKeys, ", ")
 		sb.AddConfigParam("removeKeys", str)
 	}
+	addTLSParams(sb, lokiOutput.TLSConfig, name)
+	return sb.Build()
+}
+
+func generateOTLPOutput(otlpOutput *telemetryv1alpha1.OTLPOutput, fsBufferLimit string, name string, retryPolicy *telemetryv1alpha1.RetryPolicy) string {
+	sb := NewOutputSectionBuilder()
+	sb.AddConfigParam("name", "opentelemetry")
+	sb.AddConfigParam("match", fmt.Sprintf("%s.*", name))
+	sb.AddConfigParam("alias", fmt.Sprintf("%s-opentelemetry", name))
+	sb.AddConfigParam("storage.total_limit_size", fsBufferLimit)
+	addRetrySchedule(sb, retryPolicy)
+
+	host, port := splitOTLPEndpoint(resolveValue(otlpOutput.Endpoint, name))
+	sb.AddConfigParam("host", host)
+	if port == "" {
+		port = otlpOutput.Port
+	}
+	sb.AddIfNotEmptyOrDefault("port", port, "4318")
+	sb.AddIfNotEmptyOrDefault("logs_uri", otlpOutput.Path, "/v1/logs")
+
+	compression := otlpOutput.Compression
+	if compression == "" {
+		compression = "gzip"
+	}
+	addCompression(sb, compression, true)
+
+	protocol := otlpOutput.Protocol
+	if protocol == "" {
+		protocol = "http/protobuf"
+	}
+	if protocol == "http/json" {
+		sb.AddConfigParam("header", "Content-Type application/json")
+	}
+
+	headerKeys := make([]string, 0, len(otlpOutput.Headers))
+	for k := range otlpOutput.Headers {
+		headerKeys = append(headerKeys, k)
+	}
+	sort.Strings(headerKeys)
+	for _, k := range headerKeys {
+		sb.AddConfigParam("header", fmt.Sprintf("%s %s", k, resolveValue(otlpOutput.Headers[k], name)))
+	}
+
+	addTLSParams(sb, otlpOutput.TLSConfig, name)
+
 	return sb.Build()
 }
 
+// addTLSParams adds the tls/tls.verify/tls.ca_file/tls.crt_file/tls.key_file config params shared by the HTTP,
+// Loki and OTLP output sections. CA/Cert/Key files are materialized at these paths regardless of whether the
+// material came from a manually-supplied secret or was rotated in by the cert-manager integration in
+// TLSConfig.CertificateRef, so config generation stays stable across either source.
+func addTLSParams(sb *OutputSectionBuilder, tlsConfig telemetryv1alpha1.TLSConfig, name string) {
+	tlsEnabled := "on"
+	if tlsConfig.Disabled {
+		tlsEnabled = "off"
+	}
+	sb.AddConfigParam("tls", tlsEnabled)
+	tlsVerify := "on"
+	if tlsConfig.SkipCertificateValidation {
+		tlsVerify = "off"
+	}
+	sb.AddConfigParam("tls.verify", tlsVerify)
+	if tlsConfig.HasCA() {
+		sb.AddConfigParam("tls.ca_file", fmt.Sprintf("/fluent-bit/tls/%s-ca.crt", name))
+	}
+	if tlsConfig.HasCert() {
+		sb.AddConfigParam("tls.crt_file", fmt.Sprintf("/fluent-bit/tls/%s-cert.crt", name))
+	}
+	if tlsConfig.HasKey() {
+		sb.AddConfigParam("tls.key_file", fmt.Sprintf("/fluent-bit/tls/%s-key.key", name))
+	}
+}
+
+// addCompression sets the compress config param Fluent Bit uses to compress the request body. When
+// forceContentEncodingHeader is set, an explicit Content-Encoding header is added alongside it: some output
+// plugins (e.g. opentelemetry) compress the body but do not reliably set this header themselves, and backends
+// that inspect it to decide whether to decompress would otherwise reject the payload.
+func addCompression(sb *OutputSectionBuilder, compress string, forceContentEncodingHeader bool) {
+	if compress == "" || compress == "none" {
+		return
+	}
+	sb.AddConfigParam("compress", compress)
+	if forceContentEncodingHeader {
+		sb.AddConfigParam("header", fmt.Sprintf("Content-Encoding %s", compress))
+	}
+}
+
+// addRetrySchedule adds the retry_limit and scheduler.base/scheduler.cap config params derived from retryPolicy to
+// an output section as retrySettings computes them, so every output always gets an explicit retry_limit (unlimited
+// only when MaxRetries is -1). JitterEnabled, when explicitly set to false, disables Fluent Bit's scheduler jitter.
+func addRetrySchedule(sb *OutputSectionBuilder, retryPolicy *telemetryv1alpha1.RetryPolicy) {
+	retryLimit, schedulerBase, schedulerCap := retrySettings(retryPolicy)
+	sb.AddConfigParam("retry_limit", retryLimit)
+	sb.AddConfigParam("scheduler.base", schedulerBase)
+	sb.AddConfigParam("scheduler.cap", schedulerCap)
+	if retryPolicy != nil && retryPolicy.JitterEnabled != nil && !*retryPolicy.JitterEnabled {
+		sb.AddConfigParam("scheduler.jitter", "false")
+	}
+}
+
 func concatenateLabels(labels map[string]string) string {
 	var labelsSlice []string
 	for k, v := range labels {
@@ -130,6 +292,19 @@ func concatenateLabels(labels map[string]string) string {
 	return fmt.Sprintf("{%s}", strings.Join(labelsSlice, ", "))
 }
 
+// splitOTLPEndpoint splits a resolved OTLP endpoint like "https://otlp-collector:4318" into the bare host and port
+// Fluent Bit's opentelemetry output expects as separate `host`/`port` directives, the same way generateHTTPOutput
+// already keeps Host and Port apart. An endpoint that does not parse into a host (e.g. a secret-sourced value that
+// already resolves to a bare hostname) is passed through unchanged as the host, with no port, leaving Port to
+// supply one.
+func splitOTLPEndpoint(endpoint string) (host, port string) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint, ""
+	}
+	return u.Hostname(), u.Port()
+}
+
 func resolveValue(value telemetryv1alpha1.ValueType, logPipeline string) string {
 	if value.Value != "" {
 		return value.Value
@@ -0,0 +1,46 @@
+package builder
+
+import "testing"
+
+func TestSplitOTLPEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		wantHost string
+		wantPort string
+	}{
+		{
+			name:     "scheme host and port",
+			endpoint: "https://otlp-collector:4318",
+			wantHost: "otlp-collector",
+			wantPort: "4318",
+		},
+		{
+			name:     "scheme and host without port",
+			endpoint: "http://otlp-collector",
+			wantHost: "otlp-collector",
+			wantPort: "",
+		},
+		{
+			name:     "bare hostname from a resolved secret value",
+			endpoint: "otlp-collector",
+			wantHost: "otlp-collector",
+			wantPort: "",
+		},
+		{
+			name:     "secret placeholder is passed through unchanged",
+			endpoint: "${TEST_OTLP_COLLECTOR_ENDPOINT}",
+			wantHost: "${TEST_OTLP_COLLECTOR_ENDPOINT}",
+			wantPort: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, port := splitOTLPEndpoint(tt.endpoint)
+			if host != tt.wantHost || port != tt.wantPort {
+				t.Errorf("splitOTLPEndpoint(%q) = (%q, %q), want (%q, %q)", tt.endpoint, host, port, tt.wantHost, tt.wantPort)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package builder
+
+import (
+	"strings"
+	"testing"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestAddRetrySchedule(t *testing.T) {
+	t.Run("nil policy still emits the default scheduler keys", func(t *testing.T) {
+		sb := NewOutputSectionBuilder()
+		addRetrySchedule(sb, nil)
+		got := sb.Build()
+		for _, key := range []string{"retry_limit", "scheduler.base", "scheduler.cap"} {
+			if !strings.Contains(got, key) {
+				t.Errorf("Build() = %q, want it to contain %q even when RetryPolicy is nil", got, key)
+			}
+		}
+	})
+
+	t.Run("configured policy emits scheduler keys", func(t *testing.T) {
+		base := 5
+		sb := NewOutputSectionBuilder()
+		addRetrySchedule(sb, &telemetryv1alpha1.RetryPolicy{BaseSeconds: &base})
+		got := sb.Build()
+		if !strings.Contains(got, "scheduler.base") {
+			t.Errorf("Build() = %q, want it to contain scheduler.base", got)
+		}
+	})
+}
+
+func TestEffectiveMaxRetriesMatchesDefaultRetryLimit(t *testing.T) {
+	got := effectiveMaxRetries(defaultSchedulerBaseSeconds, defaultSchedulerCapSeconds)
+	if got != defaultRetryLimit {
+		t.Errorf("effectiveMaxRetries(%d, %d) = %d, want defaultRetryLimit = %d",
+			defaultSchedulerBaseSeconds, defaultSchedulerCapSeconds, got, defaultRetryLimit)
+	}
+}
@@ -0,0 +1,97 @@
+// Package certificate integrates cert-manager.io/v1 Certificates as an alternative source of TLS material for
+// LogPipeline HTTP/Loki outputs, so users no longer have to stuff CA/cert/key bytes into a secret by hand.
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// SecretName returns the name of the Secret a Certificate created by EnsureCertificate for pipelineName
+// materializes its TLS material into.
+func SecretName(pipelineName string) string {
+	return fmt.Sprintf("%s-tls-certificate", pipelineName)
+}
+
+func certificateName(pipelineName string) string {
+	return fmt.Sprintf("%s-tls", pipelineName)
+}
+
+// EnsureCertificate reconciles the cert-manager Certificate backing ref. If ref.Name is set, it looks up that
+// existing Certificate; otherwise it creates (or updates) one from ref.IssuerRef/ref.DNSNames. It returns the name
+// of the Secret carrying the issued material and whether the Certificate is currently Ready.
+func EnsureCertificate(ctx context.Context, c client.Client, namespace, pipelineName string, ref *telemetryv1alpha1.CertificateRef) (secretName string, ready bool, err error) {
+	if ref.Name != "" {
+		var cert cmv1.Certificate
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &cert); err != nil {
+			return "", false, fmt.Errorf("failed to get referenced Certificate %s/%s: %w", namespace, ref.Name, err)
+		}
+		return cert.Spec.SecretName, IsReady(&cert), nil
+	}
+
+	name := certificateName(pipelineName)
+	secretName = SecretName(pipelineName)
+	desiredSpec := cmv1.CertificateSpec{
+		SecretName: secretName,
+		DNSNames:   ref.DNSNames,
+		IssuerRef: cmmeta.ObjectReference{
+			Name: ref.IssuerRef.Name,
+			Kind: ref.IssuerRef.Kind,
+		},
+	}
+
+	var existing cmv1.Certificate
+	getErr := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &existing)
+	switch {
+	case apierrors.IsNotFound(getErr):
+		cert := &cmv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       desiredSpec,
+		}
+		if err := c.Create(ctx, cert); err != nil {
+			return "", false, fmt.Errorf("failed to create Certificate %s/%s: %w", namespace, name, err)
+		}
+		return secretName, false, nil
+	case getErr != nil:
+		return "", false, fmt.Errorf("failed to get Certificate %s/%s: %w", namespace, name, getErr)
+	}
+
+	if !specEqual(existing.Spec, desiredSpec) {
+		existing.Spec = desiredSpec
+		if err := c.Update(ctx, &existing); err != nil {
+			return "", false, fmt.Errorf("failed to update Certificate %s/%s: %w", namespace, name, err)
+		}
+	}
+	return secretName, IsReady(&existing), nil
+}
+
+// IsReady mirrors cert-manager's own Ready condition for a Certificate.
+func IsReady(cert *cmv1.Certificate) bool {
+	for _, cond := range cert.Status.Conditions {
+		if cond.Type == cmv1.CertificateConditionReady {
+			return cond.Status == cmmeta.ConditionTrue
+		}
+	}
+	return false
+}
+
+func specEqual(a, b cmv1.CertificateSpec) bool {
+	if a.SecretName != b.SecretName || a.IssuerRef != b.IssuerRef || len(a.DNSNames) != len(b.DNSNames) {
+		return false
+	}
+	for i := range a.DNSNames {
+		if a.DNSNames[i] != b.DNSNames[i] {
+			return false
+		}
+	}
+	return true
+}
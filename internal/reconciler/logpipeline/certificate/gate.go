@@ -0,0 +1,65 @@
+package certificate
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// ShouldRender reports whether pipeline's output is ready to be included in the generated Fluent Bit config. It
+// fails closed, returning false, when an HTTP or Loki output's TLSConfig references a cert-manager Certificate via
+// CertificateRef that is not yet Ready, so Fluent Bit is never pointed at TLS files that do not exist yet. A
+// CertificateRef on an OTLP output is not checked here, and such an output is always rendered. As a
+// side effect it updates the TLSCertificateReady condition on pipeline.Status to mirror cert-manager's own
+// condition; callers are responsible for persisting the status update.
+func ShouldRender(ctx context.Context, c client.Client, pipeline *telemetryv1alpha1.LogPipeline) (bool, error) {
+	ref := certificateRefOf(pipeline)
+	if ref == nil {
+		return true, nil
+	}
+
+	_, ready, err := EnsureCertificate(ctx, c, pipeline.Namespace, pipeline.Name, ref)
+	if err != nil {
+		setCondition(pipeline, false, err.Error())
+		return false, err
+	}
+
+	if ready {
+		setCondition(pipeline, true, "cert-manager Certificate is ready")
+	} else {
+		setCondition(pipeline, false, "cert-manager Certificate is not yet ready")
+	}
+	return ready, nil
+}
+
+func certificateRefOf(pipeline *telemetryv1alpha1.LogPipeline) *telemetryv1alpha1.CertificateRef {
+	output := pipeline.Spec.Output
+	switch {
+	case output.HTTP != nil:
+		return output.HTTP.TLSConfig.CertificateRef
+	case output.Loki != nil:
+		return output.Loki.TLSConfig.CertificateRef
+	default:
+		return nil
+	}
+}
+
+func setCondition(pipeline *telemetryv1alpha1.LogPipeline, ready bool, message string) {
+	condition := metav1.Condition{
+		Type:               telemetryv1alpha1.LogPipelineTLSCertificateReady,
+		ObservedGeneration: pipeline.Generation,
+		Message:            message,
+	}
+	if ready {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "CertificateReady"
+	} else {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "CertificateNotReady"
+	}
+	meta.SetStatusCondition(&pipeline.Status.Conditions, condition)
+}
@@ -0,0 +1,53 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// VolumeAndMount returns the Secret volume and mount that surface a cert-manager-issued Certificate's material at
+// the same /fluent-bit/tls/<pipeline>-{ca.crt,cert.crt,key.key} paths a manually-supplied TLSConfig.CA/Cert/Key
+// already uses, so the builder package's config generation stays unchanged regardless of the TLS material's
+// source. tls.crt/tls.key are always projected, since cert-manager always writes them; ca.crt is only projected
+// when the Secret actually carries it, since ACME issuers (e.g. a Let's Encrypt ClusterIssuer) never populate a
+// ca.crt key, and projecting a key that does not exist would fail the whole volume mount. The kubelet refreshes
+// the mounted secret data in place on rotation, which is enough for Fluent Bit to pick up a renewed certificate on
+// its own periodic config/file checks without a pod restart.
+func VolumeAndMount(ctx context.Context, c client.Client, namespace, pipelineName, secretName string) (corev1.Volume, corev1.VolumeMount, error) {
+	volumeName := fmt.Sprintf("%s-tls-certificate", pipelineName)
+
+	items := []corev1.KeyToPath{
+		{Key: "tls.crt", Path: fmt.Sprintf("%s-cert.crt", pipelineName)},
+		{Key: "tls.key", Path: fmt.Sprintf("%s-key.key", pipelineName)},
+	}
+
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, &secret); err != nil {
+		return corev1.Volume{}, corev1.VolumeMount{}, fmt.Errorf("failed to get Secret %s/%s: %w", namespace, secretName, err)
+	}
+	if _, hasCA := secret.Data["ca.crt"]; hasCA {
+		items = append(items, corev1.KeyToPath{Key: "ca.crt", Path: fmt.Sprintf("%s-ca.crt", pipelineName)})
+	}
+
+	volume := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+				Items:      items,
+			},
+		},
+	}
+
+	mount := corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: "/fluent-bit/tls",
+		ReadOnly:  true,
+	}
+
+	return volume, mount, nil
+}
@@ -0,0 +1,64 @@
+package certificate
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestVolumeAndMountOmitsCAWhenAbsent(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme-tls", Namespace: "default"},
+		Data: map[string][]byte{
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+		},
+	}
+	c := fake.NewClientBuilder().WithObjects(secret).Build()
+
+	volume, _, err := VolumeAndMount(context.Background(), c, "default", "my-pipeline", "acme-tls")
+	if err != nil {
+		t.Fatalf("VolumeAndMount() error = %v", err)
+	}
+
+	for _, key := range []string{"tls.crt", "tls.key"} {
+		if !containsKey(volume.Secret.Items, key) {
+			t.Errorf("expected Items to contain key %q, got %v", key, volume.Secret.Items)
+		}
+	}
+	if containsKey(volume.Secret.Items, "ca.crt") {
+		t.Errorf("expected Items not to contain ca.crt when the Secret has none, got %v", volume.Secret.Items)
+	}
+}
+
+func TestVolumeAndMountIncludesCAWhenPresent(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-tls", Namespace: "default"},
+		Data: map[string][]byte{
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+			"ca.crt":  []byte("ca"),
+		},
+	}
+	c := fake.NewClientBuilder().WithObjects(secret).Build()
+
+	volume, _, err := VolumeAndMount(context.Background(), c, "default", "my-pipeline", "ca-tls")
+	if err != nil {
+		t.Fatalf("VolumeAndMount() error = %v", err)
+	}
+	if !containsKey(volume.Secret.Items, "ca.crt") {
+		t.Errorf("expected Items to contain ca.crt when the Secret has one, got %v", volume.Secret.Items)
+	}
+}
+
+func containsKey(items []corev1.KeyToPath, key string) bool {
+	for _, item := range items {
+		if item.Key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package prober
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// DefaultBuildProber returns a BuildProber that resolves the specialized OTLP/Loki probers or a generic HTTPProber
+// depending on the pipeline's output type. Custom outputs are not probed since their target is opaque Fluent Bit
+// configuration and cannot be derived generically.
+func DefaultBuildProber(c client.Client) BuildProber {
+	return func(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) (Prober, error) {
+		output := pipeline.Spec.Output
+
+		switch {
+		case output.IsOTLPDefined():
+			return buildOTLPProber(ctx, c, pipeline.Namespace, pipeline.Name, output.OTLP)
+		case output.IsLokiDefined():
+			return buildLokiProber(ctx, c, pipeline.Namespace, pipeline.Name, output.Loki)
+		case output.IsHTTPDefined():
+			return buildHTTPOutputProber(ctx, c, pipeline.Namespace, pipeline.Name, output.HTTP)
+		default:
+			return nil, nil
+		}
+	}
+}
+
+func buildHTTPOutputProber(ctx context.Context, c client.Client, namespace, pipelineName string, httpOutput *telemetryv1alpha1.HTTPOutput) (Prober, error) {
+	host, err := ResolveValue(ctx, c, httpOutput.Host)
+	if err != nil {
+		return nil, err
+	}
+	materials, err := resolveTLSMaterials(ctx, c, namespace, pipelineName, httpOutput.TLSConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := "https"
+	if httpOutput.TLSConfig.Disabled {
+		scheme = "http"
+	}
+	port := httpOutput.Port
+	if port == "" {
+		port = "443"
+	}
+
+	return &HTTPProber{
+		Endpoint:     fmt.Sprintf("%s://%s:%s%s", scheme, host, port, httpOutput.URI),
+		Method:       http.MethodGet,
+		TLSConfig:    &httpOutput.TLSConfig,
+		TLSMaterials: materials,
+	}, nil
+}
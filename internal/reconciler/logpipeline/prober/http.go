@@ -0,0 +1,113 @@
+package prober
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// TLSMaterials holds the PEM-encoded CA/client certificate/key resolved from a TLSConfig.
+type TLSMaterials struct {
+	CA   []byte
+	Cert []byte
+	Key  []byte
+}
+
+// HTTPProber probes an HTTP(S) endpoint and considers it healthy if the response status code satisfies
+// ExpectedStatusCodes, defaulting to any 2xx or 3xx response.
+type HTTPProber struct {
+	Endpoint            string
+	Method              string
+	Body                []byte
+	Headers             map[string]string
+	ExpectedStatusCodes func(statusCode int) bool
+
+	TLSConfig    *telemetryv1alpha1.TLSConfig
+	TLSMaterials TLSMaterials
+
+	Timeout time.Duration
+}
+
+// DefaultExpectedStatusCodes reports true for any 2xx or 3xx status code.
+func DefaultExpectedStatusCodes(statusCode int) bool {
+	return statusCode >= 200 && statusCode < 400
+}
+
+func (p *HTTPProber) Probe(ctx context.Context) Result {
+	start := time.Now()
+
+	client, err := p.httpClient()
+	if err != nil {
+		return Result{Duration: time.Since(start), Err: fmt.Errorf("build http client: %w", err)}
+	}
+
+	method := p.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if len(p.Body) > 0 {
+		body = bytes.NewReader(p.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.Endpoint, body)
+	if err != nil {
+		return Result{Duration: time.Since(start), Err: fmt.Errorf("build request: %w", err)}
+	}
+	for k, v := range p.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	duration := time.Since(start)
+	if err != nil {
+		return Result{Duration: duration, Err: err}
+	}
+	defer resp.Body.Close()
+
+	expected := p.ExpectedStatusCodes
+	if expected == nil {
+		expected = DefaultExpectedStatusCodes
+	}
+	if !expected(resp.StatusCode) {
+		return Result{Duration: duration, Err: fmt.Errorf("unexpected status code %d", resp.StatusCode)}
+	}
+
+	return Result{Healthy: true, Duration: duration}
+}
+
+func (p *HTTPProber) httpClient() (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if p.TLSConfig != nil {
+		tlsConfig.InsecureSkipVerify = p.TLSConfig.SkipCertificateValidation //nolint:gosec // explicit opt-in via TLSConfig.SkipCertificateValidation
+
+		if len(p.TLSMaterials.CA) > 0 {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(p.TLSMaterials.CA) {
+				return nil, fmt.Errorf("invalid CA certificate")
+			}
+			tlsConfig.RootCAs = pool
+		}
+
+		if len(p.TLSMaterials.Cert) > 0 && len(p.TLSMaterials.Key) > 0 {
+			cert, err := tls.X509KeyPair(p.TLSMaterials.Cert, p.TLSMaterials.Key)
+			if err != nil {
+				return nil, fmt.Errorf("load client certificate: %w", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	return &http.Client{
+		Timeout:   p.Timeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, nil
+}
@@ -0,0 +1,41 @@
+package prober
+
+import (
+	"context"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// LokiProber probes a Grafana Loki endpoint's /ready handler.
+type LokiProber struct {
+	http HTTPProber
+}
+
+func NewLokiProber(endpoint string, tlsConfig *telemetryv1alpha1.TLSConfig, tlsMaterials TLSMaterials) *LokiProber {
+	return &LokiProber{
+		http: HTTPProber{
+			Endpoint:     strings.TrimSuffix(endpoint, "/") + "/ready",
+			TLSConfig:    tlsConfig,
+			TLSMaterials: tlsMaterials,
+		},
+	}
+}
+
+func (p *LokiProber) Probe(ctx context.Context) Result {
+	return p.http.Probe(ctx)
+}
+
+func buildLokiProber(ctx context.Context, c client.Client, namespace, pipelineName string, lokiOutput *telemetryv1alpha1.LokiOutput) (Prober, error) {
+	endpoint, err := ResolveValue(ctx, c, lokiOutput.URL)
+	if err != nil {
+		return nil, err
+	}
+	materials, err := resolveTLSMaterials(ctx, c, namespace, pipelineName, lokiOutput.TLSConfig)
+	if err != nil {
+		return nil, err
+	}
+	return NewLokiProber(endpoint, &lokiOutput.TLSConfig, materials), nil
+}
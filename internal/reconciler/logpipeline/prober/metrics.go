@@ -0,0 +1,22 @@
+package prober
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	ProbeDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "telemetry_logpipeline_output_probe_duration_seconds",
+		Help: "Duration of LogPipeline output endpoint probes, in seconds.",
+	}, []string{"pipeline"})
+
+	ProbeSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "telemetry_logpipeline_output_probe_success",
+		Help: "Whether the last probe of a LogPipeline output endpoint succeeded (1) or failed (0).",
+	}, []string{"pipeline"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(ProbeDurationSeconds, ProbeSuccess)
+}
@@ -0,0 +1,74 @@
+package prober
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// OTLPProber probes an OTLP/HTTP logs endpoint by POSTing an empty ExportLogsServiceRequest. A 2xx/3xx response
+// indicates the collector accepted the (empty) export and is therefore considered reachable.
+type OTLPProber struct {
+	http HTTPProber
+}
+
+func NewOTLPProber(endpoint string, tlsConfig *telemetryv1alpha1.TLSConfig, tlsMaterials TLSMaterials, headers map[string]string) (*OTLPProber, error) {
+	body, err := proto.Marshal(&collogspb.ExportLogsServiceRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("marshal empty ExportLogsServiceRequest: %w", err)
+	}
+
+	mergedHeaders := make(map[string]string, len(headers)+1)
+	for k, v := range headers {
+		mergedHeaders[k] = v
+	}
+	mergedHeaders["Content-Type"] = "application/x-protobuf"
+
+	return &OTLPProber{
+		http: HTTPProber{
+			Endpoint:     endpoint,
+			Method:       http.MethodPost,
+			Body:         body,
+			Headers:      mergedHeaders,
+			TLSConfig:    tlsConfig,
+			TLSMaterials: tlsMaterials,
+		},
+	}, nil
+}
+
+func (p *OTLPProber) Probe(ctx context.Context) Result {
+	return p.http.Probe(ctx)
+}
+
+func buildOTLPProber(ctx context.Context, c client.Client, namespace, pipelineName string, otlpOutput *telemetryv1alpha1.OTLPOutput) (Prober, error) {
+	endpoint, err := ResolveValue(ctx, c, otlpOutput.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	materials, err := resolveTLSMaterials(ctx, c, namespace, pipelineName, otlpOutput.TLSConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	path := otlpOutput.Path
+	if path == "" {
+		path = "/v1/logs"
+	}
+
+	headers := make(map[string]string, len(otlpOutput.Headers))
+	for k, v := range otlpOutput.Headers {
+		resolved, err := ResolveValue(ctx, c, v)
+		if err != nil {
+			return nil, err
+		}
+		headers[k] = resolved
+	}
+
+	return NewOTLPProber(endpoint+path, &otlpOutput.TLSConfig, materials, headers)
+}
@@ -0,0 +1,22 @@
+// Package prober periodically probes the resolved output endpoint of each LogPipeline, analogous to a blackbox
+// exporter, and surfaces the result as an OutputHealthy status condition plus Prometheus metrics. This lets users
+// detect a broken sink before Fluent Bit exhausts its retry budget and silently drops buffered logs.
+package prober
+
+import (
+	"context"
+	"time"
+)
+
+// Result is the outcome of a single probe attempt against a pipeline's output endpoint.
+type Result struct {
+	Healthy  bool
+	Duration time.Duration
+	Err      error
+}
+
+// Prober probes a single resolved output endpoint and reports whether it is reachable. Implementations must not
+// retain the context beyond the call.
+type Prober interface {
+	Probe(ctx context.Context) Result
+}
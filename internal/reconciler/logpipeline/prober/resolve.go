@@ -0,0 +1,79 @@
+package prober
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+	"github.com/kyma-project/telemetry-manager/internal/reconciler/logpipeline/certificate"
+)
+
+// ResolveValue resolves a ValueType with the same precedence as Fluent Bit config generation (an inline Value wins
+// over ValueFrom), but reads the referenced secret directly from the Kubernetes API instead of emitting a Fluent
+// Bit environment variable reference, since the prober runs in the controller process rather than the Fluent Bit
+// container.
+func ResolveValue(ctx context.Context, c client.Client, value telemetryv1alpha1.ValueType) (string, error) {
+	if value.Value != "" {
+		return value.Value, nil
+	}
+	if value.ValueFrom == nil || !value.ValueFrom.IsSecretKeyRef() {
+		return "", nil
+	}
+
+	ref := value.ValueFrom.SecretKeyRef
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, &secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in secret %s/%s", ref.Key, ref.Namespace, ref.Name)
+	}
+	return string(data), nil
+}
+
+// resolveTLSMaterials resolves the CA/client certificate/key referenced by a TLSConfig into PEM-encoded bytes. If
+// tlsConfig.CertificateRef is set, the material is read from the Secret backing the cert-manager Certificate
+// instead, mirroring how certificate.VolumeAndMount sources the material Fluent Bit mounts, so the prober's notion
+// of trust matches what Fluent Bit actually uses.
+func resolveTLSMaterials(ctx context.Context, c client.Client, namespace, pipelineName string, tlsConfig telemetryv1alpha1.TLSConfig) (TLSMaterials, error) {
+	if tlsConfig.CertificateRef != nil {
+		return resolveCertificateRefMaterials(ctx, c, namespace, pipelineName, tlsConfig.CertificateRef)
+	}
+
+	ca, err := ResolveValue(ctx, c, tlsConfig.CA)
+	if err != nil {
+		return TLSMaterials{}, err
+	}
+	cert, err := ResolveValue(ctx, c, tlsConfig.Cert)
+	if err != nil {
+		return TLSMaterials{}, err
+	}
+	key, err := ResolveValue(ctx, c, tlsConfig.Key)
+	if err != nil {
+		return TLSMaterials{}, err
+	}
+	return TLSMaterials{CA: []byte(ca), Cert: []byte(cert), Key: []byte(key)}, nil
+}
+
+// resolveCertificateRefMaterials reads the CA/cert/key bytes out of the Secret that
+// certificate.EnsureCertificate's Certificate materializes its TLS material into. ca.crt is only present when the
+// issuer actually populates it (e.g. not for ACME-issued certificates), same as certificate.VolumeAndMount.
+func resolveCertificateRefMaterials(ctx context.Context, c client.Client, namespace, pipelineName string, ref *telemetryv1alpha1.CertificateRef) (TLSMaterials, error) {
+	secretName, _, err := certificate.EnsureCertificate(ctx, c, namespace, pipelineName, ref)
+	if err != nil {
+		return TLSMaterials{}, err
+	}
+
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, &secret); err != nil {
+		return TLSMaterials{}, fmt.Errorf("failed to get Secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	return TLSMaterials{CA: secret.Data["ca.crt"], Cert: secret.Data["tls.crt"], Key: secret.Data["tls.key"]}, nil
+}
@@ -0,0 +1,168 @@
+package prober
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// tickResolution is how often the scheduler re-checks which pipelines are due for a probe. It should be smaller
+// than any realistic Flags.Interval/AnnotationProbeInterval so per-pipeline overrides take effect promptly.
+const tickResolution = 10 * time.Second
+
+// Flags configures the default probe cadence and timeout. Either can be overridden per pipeline via the
+// telemetry.kyma-project.io/probe-interval and telemetry.kyma-project.io/probe-timeout annotations.
+type Flags struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// BuildProber resolves a Prober for the given pipeline's output, returning a nil Prober (and nil error) if the
+// output type does not support probing.
+type BuildProber func(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) (Prober, error)
+
+// Scheduler periodically probes every LogPipeline's output endpoint and writes the result into the OutputHealthy
+// status condition, alongside the ProbeDurationSeconds/ProbeSuccess metrics.
+type Scheduler struct {
+	Client      client.Client
+	Flags       Flags
+	BuildProber BuildProber
+
+	mu         sync.Mutex
+	lastProbed map[string]time.Time
+}
+
+// Start blocks, checking which pipelines are due for a probe every tickResolution, until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(tickResolution)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.probeAll(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) probeAll(ctx context.Context) {
+	var pipelines telemetryv1alpha1.LogPipelineList
+	if err := s.Client.List(ctx, &pipelines); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list LogPipelines for output probing")
+		return
+	}
+
+	for i := range pipelines.Items {
+		pipeline := &pipelines.Items[i]
+		if !s.due(pipeline) {
+			continue
+		}
+		go s.probeOne(ctx, pipeline)
+	}
+}
+
+// due reports whether pipeline has not been probed within its effective interval, and if so marks it as probed now.
+func (s *Scheduler) due(pipeline *telemetryv1alpha1.LogPipeline) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lastProbed == nil {
+		s.lastProbed = make(map[string]time.Time)
+	}
+
+	now := time.Now()
+	if last, ok := s.lastProbed[pipeline.Name]; ok && now.Sub(last) < s.probeInterval(pipeline) {
+		return false
+	}
+	s.lastProbed[pipeline.Name] = now
+	return true
+}
+
+func (s *Scheduler) probeInterval(pipeline *telemetryv1alpha1.LogPipeline) time.Duration {
+	if raw, ok := pipeline.Annotations[telemetryv1alpha1.AnnotationProbeInterval]; ok {
+		if d, err := time.ParseDuration(raw); err == nil {
+			return d
+		}
+	}
+	if s.Flags.Interval > 0 {
+		return s.Flags.Interval
+	}
+	return time.Minute
+}
+
+func (s *Scheduler) probeOne(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline) {
+	prober, err := s.BuildProber(ctx, pipeline)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to build output prober", "pipeline", pipeline.Name)
+		return
+	}
+	if prober == nil {
+		return
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, s.probeTimeout(pipeline))
+	defer cancel()
+
+	result := prober.Probe(probeCtx)
+	ProbeDurationSeconds.WithLabelValues(pipeline.Name).Observe(result.Duration.Seconds())
+	if result.Healthy {
+		ProbeSuccess.WithLabelValues(pipeline.Name).Set(1)
+	} else {
+		ProbeSuccess.WithLabelValues(pipeline.Name).Set(0)
+	}
+
+	if err := s.writeCondition(ctx, pipeline, result); err != nil {
+		log.FromContext(ctx).Error(err, "failed to update OutputHealthy condition", "pipeline", pipeline.Name)
+	}
+}
+
+func (s *Scheduler) probeTimeout(pipeline *telemetryv1alpha1.LogPipeline) time.Duration {
+	if raw, ok := pipeline.Annotations[telemetryv1alpha1.AnnotationProbeTimeout]; ok {
+		if d, err := time.ParseDuration(raw); err == nil {
+			return d
+		}
+	}
+	if s.Flags.Timeout > 0 {
+		return s.Flags.Timeout
+	}
+	return 10 * time.Second
+}
+
+// writeCondition sets the OutputHealthy condition on pipeline's status. It re-fetches the pipeline and retries on
+// conflict rather than updating the possibly stale copy probeAll listed, since the main LogPipeline reconciler can
+// write to the same status concurrently between the list and this update.
+func (s *Scheduler) writeCondition(ctx context.Context, pipeline *telemetryv1alpha1.LogPipeline, result Result) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var latest telemetryv1alpha1.LogPipeline
+		if err := s.Client.Get(ctx, client.ObjectKeyFromObject(pipeline), &latest); err != nil {
+			return err
+		}
+
+		condition := metav1.Condition{
+			Type:               telemetryv1alpha1.LogPipelineOutputHealthy,
+			ObservedGeneration: latest.Generation,
+		}
+		if result.Healthy {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "ProbeSucceeded"
+			condition.Message = "output endpoint responded successfully"
+		} else {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "ProbeFailed"
+			condition.Message = result.Err.Error()
+		}
+
+		meta.SetStatusCondition(&latest.Status.Conditions, condition)
+		return s.Client.Status().Update(ctx, &latest)
+	})
+}
@@ -0,0 +1,71 @@
+package prober
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+func TestProbeInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		flags    Flags
+		pipeline *telemetryv1alpha1.LogPipeline
+		want     time.Duration
+	}{
+		{
+			name:     "no override uses built-in default",
+			pipeline: &telemetryv1alpha1.LogPipeline{},
+			want:     time.Minute,
+		},
+		{
+			name:     "Flags.Interval overrides built-in default",
+			flags:    Flags{Interval: 30 * time.Second},
+			pipeline: &telemetryv1alpha1.LogPipeline{},
+			want:     30 * time.Second,
+		},
+		{
+			name:  "annotation overrides Flags.Interval",
+			flags: Flags{Interval: 30 * time.Second},
+			pipeline: &telemetryv1alpha1.LogPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{telemetryv1alpha1.AnnotationProbeInterval: "5s"},
+				},
+			},
+			want: 5 * time.Second,
+		},
+		{
+			name: "unparsable annotation falls back to built-in default",
+			pipeline: &telemetryv1alpha1.LogPipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{telemetryv1alpha1.AnnotationProbeInterval: "not-a-duration"},
+				},
+			},
+			want: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{Flags: tt.flags}
+			if got := s.probeInterval(tt.pipeline); got != tt.want {
+				t.Errorf("probeInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchedulerDue(t *testing.T) {
+	s := &Scheduler{Flags: Flags{Interval: time.Hour}}
+	pipeline := &telemetryv1alpha1.LogPipeline{ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline"}}
+
+	if !s.due(pipeline) {
+		t.Fatal("due() = false on first check, want true")
+	}
+	if s.due(pipeline) {
+		t.Fatal("due() = true immediately after being probed, want false")
+	}
+}
@@ -0,0 +1,23 @@
+// Package fluentbit builds Kubernetes resources for the Fluent Bit DaemonSet.
+package fluentbit
+
+const (
+	// metricsPort is the port Fluent Bit's built-in HTTP server exposes its Prometheus-formatted metrics on.
+	metricsPort = "2020"
+	// metricsPath is Fluent Bit's Prometheus-formatted metrics endpoint.
+	metricsPath = "/api/v1/metrics/prometheus"
+)
+
+// ScrapeAnnotations returns the pod annotations that make Fluent Bit's built-in Prometheus endpoint discoverable
+// by a Prometheus scrape-annotation-based setup, so per-pipeline compression/error metrics become visible without
+// a dedicated exporter sidecar. It is the annotation-based counterpart to BuildPodMonitor/ReconcilePodMonitor:
+// whichever Prometheus discovery mechanism a given deployment uses, the Fluent Bit DaemonSet's pod template must
+// merge this map into its own annotations for these metrics to be scraped at all. This codebase does not yet
+// contain that DaemonSet's pod template builder, so nothing merges this map in today.
+func ScrapeAnnotations() map[string]string {
+	return map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   metricsPort,
+		"prometheus.io/path":   metricsPath,
+	}
+}
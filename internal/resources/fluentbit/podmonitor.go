@@ -0,0 +1,97 @@
+package fluentbit
+
+import (
+	"context"
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// podMonitorName is the name of the PodMonitor that makes Fluent Bit's metrics endpoint discoverable to
+// prometheus-operator.
+const podMonitorName = "telemetry-fluent-bit-metrics"
+
+// metricsPortName must match the name of the container port the Fluent Bit DaemonSet's pod template exposes
+// metricsPort under, since PodMonitor endpoints are resolved by port name rather than number.
+const metricsPortName = "http-metrics"
+
+// BuildPodMonitor returns the PodMonitor that scrapes Fluent Bit's built-in Prometheus endpoint on every pod
+// matched by selectorLabels (the labels the Fluent Bit DaemonSet's pod template carries), relabeling the
+// per-output metrics as described by MetricRelabelConfigs.
+func BuildPodMonitor(namespace string, selectorLabels map[string]string) *monitoringv1.PodMonitor {
+	return &monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podMonitorName,
+			Namespace: namespace,
+		},
+		Spec: monitoringv1.PodMonitorSpec{
+			Selector: metav1.LabelSelector{MatchLabels: selectorLabels},
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{
+					Port:                 metricsPortName,
+					Path:                 metricsPath,
+					MetricRelabelConfigs: MetricRelabelConfigs(),
+				},
+			},
+		},
+	}
+}
+
+// ReconcilePodMonitor creates or updates the PodMonitor built by BuildPodMonitor, so Fluent Bit's metrics actually
+// become scrapable by prometheus-operator. This codebase does not yet contain a Fluent Bit DaemonSet reconciler to
+// call this from; it is provided so that one can, without duplicating the create-or-update logic.
+func ReconcilePodMonitor(ctx context.Context, c client.Client, namespace string, selectorLabels map[string]string) error {
+	desired := BuildPodMonitor(namespace, selectorLabels)
+
+	var existing monitoringv1.PodMonitor
+
+	err := c.Get(ctx, types.NamespacedName{Name: podMonitorName, Namespace: namespace}, &existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		if err := c.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create PodMonitor %s/%s: %w", namespace, podMonitorName, err)
+		}
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get PodMonitor %s/%s: %w", namespace, podMonitorName, err)
+	}
+
+	existing.Spec = desired.Spec
+	if err := c.Update(ctx, &existing); err != nil {
+		return fmt.Errorf("failed to update PodMonitor %s/%s: %w", namespace, podMonitorName, err)
+	}
+	return nil
+}
+
+// MetricRelabelConfigs rewrites Fluent Bit's built-in fluentbit_output_proc_bytes_total/fluentbit_output_errors_total
+// metrics into telemetry_logpipeline_output_{bytes,errors}_total{pipeline=...}. The pipeline label is derived from
+// the output's "name" label, which createOutputSection sets to the "<pipeline>-<plugin>" alias convention (e.g.
+// "my-pipeline-http") for HTTP, Loki and OTLP outputs. A custom output gets the pipeline label only if its alias
+// ends in "-http", "-grafana-loki" or "-opentelemetry"; a custom output with a user-given alias or another plugin
+// name is not labeled.
+func MetricRelabelConfigs() []monitoringv1.RelabelConfig {
+	return []monitoringv1.RelabelConfig{
+		{
+			SourceLabels: []monitoringv1.LabelName{"__name__"},
+			Regex:        "fluentbit_output_proc_bytes_total",
+			TargetLabel:  "__name__",
+			Replacement:  "telemetry_logpipeline_output_bytes_total",
+		},
+		{
+			SourceLabels: []monitoringv1.LabelName{"__name__"},
+			Regex:        "fluentbit_output_errors_total",
+			TargetLabel:  "__name__",
+			Replacement:  "telemetry_logpipeline_output_errors_total",
+		},
+		{
+			SourceLabels: []monitoringv1.LabelName{"name"},
+			Regex:        "(.+)-(?:http|grafana-loki|opentelemetry)",
+			TargetLabel:  "pipeline",
+			Replacement:  "$1",
+		},
+	}
+}
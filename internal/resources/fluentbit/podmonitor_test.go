@@ -0,0 +1,81 @@
+package fluentbit
+
+import (
+	"context"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBuildPodMonitor(t *testing.T) {
+	selectorLabels := map[string]string{"app.kubernetes.io/name": "fluent-bit"}
+	pm := BuildPodMonitor("kyma-system", selectorLabels)
+
+	if pm.Namespace != "kyma-system" {
+		t.Errorf("Namespace = %q, want %q", pm.Namespace, "kyma-system")
+	}
+	if len(pm.Spec.PodMetricsEndpoints) != 1 {
+		t.Fatalf("PodMetricsEndpoints = %v, want exactly one endpoint", pm.Spec.PodMetricsEndpoints)
+	}
+
+	endpoint := pm.Spec.PodMetricsEndpoints[0]
+	if endpoint.Path != metricsPath {
+		t.Errorf("Path = %q, want %q", endpoint.Path, metricsPath)
+	}
+	if len(endpoint.MetricRelabelConfigs) != len(MetricRelabelConfigs()) {
+		t.Errorf("MetricRelabelConfigs = %v, want %v", endpoint.MetricRelabelConfigs, MetricRelabelConfigs())
+	}
+	if pm.Spec.Selector.MatchLabels["app.kubernetes.io/name"] != "fluent-bit" {
+		t.Errorf("Selector.MatchLabels = %v, want to contain the given selectorLabels", pm.Spec.Selector.MatchLabels)
+	}
+}
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := monitoringv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	return scheme
+}
+
+func TestReconcilePodMonitorCreatesWhenAbsent(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).Build()
+	selectorLabels := map[string]string{"app.kubernetes.io/name": "fluent-bit"}
+
+	if err := ReconcilePodMonitor(context.Background(), c, "kyma-system", selectorLabels); err != nil {
+		t.Fatalf("ReconcilePodMonitor() error = %v", err)
+	}
+
+	var pm monitoringv1.PodMonitor
+	if err := c.Get(context.Background(), types.NamespacedName{Name: podMonitorName, Namespace: "kyma-system"}, &pm); err != nil {
+		t.Fatalf("expected PodMonitor to be created, get failed: %v", err)
+	}
+}
+
+func TestReconcilePodMonitorUpdatesWhenPresent(t *testing.T) {
+	existing := &monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: podMonitorName, Namespace: "kyma-system"},
+		Spec: monitoringv1.PodMonitorSpec{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"stale": "true"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(existing).Build()
+	selectorLabels := map[string]string{"app.kubernetes.io/name": "fluent-bit"}
+
+	if err := ReconcilePodMonitor(context.Background(), c, "kyma-system", selectorLabels); err != nil {
+		t.Fatalf("ReconcilePodMonitor() error = %v", err)
+	}
+
+	var pm monitoringv1.PodMonitor
+	if err := c.Get(context.Background(), types.NamespacedName{Name: podMonitorName, Namespace: "kyma-system"}, &pm); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if pm.Spec.Selector.MatchLabels["app.kubernetes.io/name"] != "fluent-bit" {
+		t.Errorf("Selector.MatchLabels = %v, want updated selectorLabels", pm.Spec.Selector.MatchLabels)
+	}
+}
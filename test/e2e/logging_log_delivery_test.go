@@ -26,6 +26,7 @@ type OutputType string
 const (
 	OutputTypeHTTP   = "http"
 	OutputTypeCustom = "custom"
+	OutputTypeOTLP   = "otlp"
 )
 
 var _ = Describe("Logging", Label("logging"), func() {
@@ -85,6 +86,36 @@ var _ = Describe("Logging", Label("logging"), func() {
 			logsShouldBeDelivered(logProducerName, urls.MockBackendExport(mockDeploymentName))
 		})
 	})
+
+	Context("When a logpipeline with OTLP output exists", Ordered, func() {
+		var (
+			urls               *urlprovider.URLProvider
+			mockDeploymentName = "log-receiver"
+			mockNs             = "log-otlp-output"
+			logProducerName    = "log-producer-otlp-output" //#nosec G101 -- This is a false positive
+		)
+
+		BeforeAll(func() {
+			k8sObjects, logsURLProvider := makeLogDeliveryTestK8sObjects(mockNs, mockDeploymentName, logProducerName, OutputTypeOTLP)
+			urls = logsURLProvider
+			DeferCleanup(func() {
+				Expect(kitk8s.DeleteObjects(ctx, k8sClient, k8sObjects...)).Should(Succeed())
+			})
+			Expect(kitk8s.CreateObjects(ctx, k8sClient, k8sObjects...)).Should(Succeed())
+		})
+
+		It("Should have a log backend running", Label("operational"), func() {
+			logBackendShouldBeRunning(mockDeploymentName, mockNs)
+		})
+
+		It("Should have a log producer running", func() {
+			deploymentShouldBeReady(logProducerName, mockNs)
+		})
+
+		It("Should verify end-to-end log delivery with OTLP", Label("operational"), func() {
+			logsShouldBeDelivered(logProducerName, urls.MockBackendExport(mockDeploymentName))
+		})
+	})
 })
 
 // TODO this function is the same as deploymentShouldBeRunning except that the timeout is doubled
@@ -125,9 +156,12 @@ func makeLogDeliveryTestK8sObjects(namespace string, mockDeploymentName string,
 
 	// Default namespace objects.
 	var logPipeline *kitlog.Pipeline
-	if outputType == OutputTypeHTTP {
+	switch outputType {
+	case OutputTypeHTTP:
 		logPipeline = kitlog.NewPipeline("http-output-pipeline").WithSecretKeyRef(mockBackend.GetHostSecretRefKey()).WithHTTPOutput()
-	} else {
+	case OutputTypeOTLP:
+		logPipeline = kitlog.NewPipeline("otlp-output-pipeline").WithSecretKeyRef(mockBackend.GetHostSecretRefKey()).WithOTLPOutput()
+	default:
 		logPipeline = kitlog.NewPipeline("custom-output-pipeline").WithCustomOutput(mockBackend.ExternalService.Host()) // TODO check if it makes sense to extract the host into a Backend function
 	}
 	objs = append(objs, logPipeline.K8sObject())
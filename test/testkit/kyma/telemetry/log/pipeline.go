@@ -0,0 +1,70 @@
+// Package log provides a builder for telemetryv1alpha1.LogPipeline objects used by the logging e2e suite.
+package log
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	telemetryv1alpha1 "github.com/kyma-project/telemetry-manager/apis/telemetry/v1alpha1"
+)
+
+// Pipeline builds a telemetryv1alpha1.LogPipeline for use in e2e tests.
+type Pipeline struct {
+	persistentHostSecretRef telemetryv1alpha1.SecretKeyRef
+	pipeline                telemetryv1alpha1.LogPipeline
+}
+
+// NewPipeline creates a Pipeline builder for a LogPipeline named name. Configure its output with one of
+// WithHTTPOutput, WithOTLPOutput, or WithCustomOutput before calling K8sObject.
+func NewPipeline(name string) *Pipeline {
+	return &Pipeline{
+		pipeline: telemetryv1alpha1.LogPipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		},
+	}
+}
+
+func (p *Pipeline) Name() string {
+	return p.pipeline.Name
+}
+
+// WithSecretKeyRef records the secret key ref used by WithHTTPOutput/WithOTLPOutput to resolve the backend host.
+func (p *Pipeline) WithSecretKeyRef(secretKeyRef telemetryv1alpha1.SecretKeyRef) *Pipeline {
+	p.persistentHostSecretRef = secretKeyRef
+	return p
+}
+
+func (p *Pipeline) hostValue() telemetryv1alpha1.ValueType {
+	return telemetryv1alpha1.ValueType{
+		ValueFrom: &telemetryv1alpha1.ValueFromType{SecretKeyRef: &p.persistentHostSecretRef},
+	}
+}
+
+// WithHTTPOutput configures an HTTP output resolving its host from the secret set via WithSecretKeyRef.
+func (p *Pipeline) WithHTTPOutput() *Pipeline {
+	p.pipeline.Spec.Output = telemetryv1alpha1.Output{
+		HTTP: &telemetryv1alpha1.HTTPOutput{Host: p.hostValue()},
+	}
+	return p
+}
+
+// WithOTLPOutput configures an OTLP output resolving its endpoint from the secret set via WithSecretKeyRef.
+func (p *Pipeline) WithOTLPOutput() *Pipeline {
+	p.pipeline.Spec.Output = telemetryv1alpha1.Output{
+		OTLP: &telemetryv1alpha1.OTLPOutput{Endpoint: p.hostValue()},
+	}
+	return p
+}
+
+// WithCustomOutput configures a custom Fluent Bit output pointing directly at host.
+func (p *Pipeline) WithCustomOutput(host string) *Pipeline {
+	p.pipeline.Spec.Output = telemetryv1alpha1.Output{
+		Custom: "Name http\nHost " + host + "\n",
+	}
+	return p
+}
+
+// K8sObject returns the built LogPipeline as a client.Object.
+func (p *Pipeline) K8sObject() client.Object {
+	return &p.pipeline
+}